@@ -0,0 +1,345 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceDataFactoryCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataFactoryCredentialCreateUpdate,
+		Read:   resourceDataFactoryCredentialRead,
+		Update: resourceDataFactoryCredentialCreateUpdate,
+		Delete: resourceDataFactoryCredentialDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CredentialName(),
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"identity": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"identity", "service_principal"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"service_principal": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"identity", "service_principal"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"service_principal_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"service_principal_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryCredentialCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_credential", *existing.ID)
+		}
+	}
+
+	description := d.Get("description").(string)
+	annotations := d.Get("annotations").([]interface{})
+	additionalProperties := d.Get("additional_properties").(map[string]interface{})
+
+	var credential datafactory.BasicCredential
+
+	if v, ok := d.GetOk("identity"); ok {
+		credential = expandDataFactoryCredentialManagedIdentity(v.([]interface{}), description, annotations, additionalProperties)
+	}
+
+	if v, ok := d.GetOk("service_principal"); ok {
+		credential = expandDataFactoryCredentialServicePrincipal(v.([]interface{}), description, annotations, additionalProperties)
+	}
+
+	credentialResource := datafactory.CredentialResource{
+		Properties: credential,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, credentialResource, ""); err != nil {
+		return fmt.Errorf("creating/updating Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("cannot read Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceDataFactoryCredentialRead(d, meta)
+}
+
+func resourceDataFactoryCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", id.Name, id.FactoryName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", id.FactoryName)
+
+	switch credential := resp.Properties.(type) {
+	case datafactory.ManagedIdentityCredential:
+		d.Set("description", credential.Description)
+		d.Set("additional_properties", credential.AdditionalProperties)
+
+		if err := d.Set("identity", flattenDataFactoryCredentialManagedIdentity(&credential)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if err := d.Set("annotations", flattenDataFactoryAnnotations(credential.Annotations)); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+
+	case datafactory.ServicePrincipalCredential:
+		d.Set("description", credential.Description)
+		d.Set("additional_properties", credential.AdditionalProperties)
+
+		if err := d.Set("service_principal", flattenDataFactoryCredentialServicePrincipal(&credential, d)); err != nil {
+			return fmt.Errorf("setting `service_principal`: %+v", err)
+		}
+
+		if err := d.Set("annotations", flattenDataFactoryAnnotations(credential.Annotations)); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+
+	default:
+		return fmt.Errorf("classifying Data Factory Credential %q (Data Factory %q / Resource Group %q): unrecognised credential type", id.Name, id.FactoryName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func resourceDataFactoryCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Data Factory Credential %q (Data Factory %q / Resource Group %q): %+v", id.Name, id.FactoryName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryCredentialManagedIdentity(input []interface{}, description string, annotations []interface{}, additionalProperties map[string]interface{}) datafactory.ManagedIdentityCredential {
+	raw := input[0].(map[string]interface{})
+
+	credential := datafactory.ManagedIdentityCredential{
+		Description: utils.String(description),
+		TypeProperties: &datafactory.ManagedIdentityTypeProperties{
+			ResourceID: utils.String(raw["user_assigned_identity_id"].(string)),
+		},
+		Type:                 datafactory.TypeManagedIdentity,
+		AdditionalProperties: additionalProperties,
+	}
+
+	if len(annotations) > 0 {
+		credential.Annotations = &annotations
+	}
+
+	return credential
+}
+
+func flattenDataFactoryCredentialManagedIdentity(input *datafactory.ManagedIdentityCredential) []interface{} {
+	if input == nil || input.TypeProperties == nil || input.TypeProperties.ResourceID == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"user_assigned_identity_id": *input.TypeProperties.ResourceID,
+		},
+	}
+}
+
+func expandDataFactoryCredentialServicePrincipal(input []interface{}, description string, annotations []interface{}, additionalProperties map[string]interface{}) datafactory.ServicePrincipalCredential {
+	raw := input[0].(map[string]interface{})
+
+	typeProperties := &datafactory.ServicePrincipalCredentialTypeProperties{
+		ServicePrincipalID: utils.String(raw["service_principal_id"].(string)),
+		Tenant:             utils.String(raw["tenant_id"].(string)),
+	}
+
+	if key := raw["service_principal_key"].(string); key != "" {
+		typeProperties.ServicePrincipalKey = &datafactory.SecureString{
+			Value: utils.String(key),
+			Type:  datafactory.TypeSecureString,
+		}
+	}
+
+	credential := datafactory.ServicePrincipalCredential{
+		Description:                              utils.String(description),
+		ServicePrincipalCredentialTypeProperties: typeProperties,
+		Type:                 datafactory.TypeServicePrincipal,
+		AdditionalProperties: additionalProperties,
+	}
+
+	if len(annotations) > 0 {
+		credential.Annotations = &annotations
+	}
+
+	return credential
+}
+
+func flattenDataFactoryCredentialServicePrincipal(input *datafactory.ServicePrincipalCredential, d *schema.ResourceData) []interface{} {
+	if input == nil || input.ServicePrincipalCredentialTypeProperties == nil {
+		return []interface{}{}
+	}
+
+	servicePrincipalID := ""
+	if input.ServicePrincipalID != nil {
+		servicePrincipalID = *input.ServicePrincipalID
+	}
+
+	tenantID := ""
+	if input.Tenant != nil {
+		tenantID = *input.Tenant
+	}
+
+	// the Azure API never returns the secret, so preserve whatever's already in state
+	servicePrincipalKey := d.Get("service_principal.0.service_principal_key").(string)
+
+	return []interface{}{
+		map[string]interface{}{
+			"service_principal_id":  servicePrincipalID,
+			"service_principal_key": servicePrincipalKey,
+			"tenant_id":             tenantID,
+		},
+	}
+}
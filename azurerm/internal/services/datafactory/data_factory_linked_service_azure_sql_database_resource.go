@@ -53,13 +53,58 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *schema.Resource {
 			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
+			// `connection_string` can either be set directly or assembled from the
+			// structured `server`/`database`/... fields below when omitted.
 			"connection_string": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				DiffSuppressFunc: azureRmDataFactoryLinkedServiceConnectionStringDiff,
 				ValidateFunc:     validation.StringIsNotEmpty,
 			},
 
+			"server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"database"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"server"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"encrypt": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"trust_server_certificate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"connection_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"application_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"failover_partner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -93,6 +138,31 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *schema.Resource {
 				Default:  false,
 				ConflictsWith: []string{
 					"service_principal_id",
+					"credential",
+				},
+			},
+
+			// Reference to an `azurerm_data_factory_credential` resource. This is also how
+			// a user-assigned managed identity is wired in: create a `ManagedIdentity`
+			// typed `azurerm_data_factory_credential` holding the identity's resource ID
+			// and reference its name here - `CredentialReference.ReferenceName` must be
+			// the *name* of that Credential object, not a raw ARM resource ID.
+			"credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"use_managed_identity",
+					"service_principal_id",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.CredentialName(),
+						},
+					},
 				},
 			},
 
@@ -103,6 +173,7 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *schema.Resource {
 				RequiredWith: []string{"service_principal_key"},
 				ConflictsWith: []string{
 					"use_managed_identity",
+					"credential",
 				},
 			},
 
@@ -111,6 +182,51 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				RequiredWith: []string{"service_principal_id"},
+				ConflictsWith: []string{
+					"service_principal_credential",
+				},
+			},
+
+			"service_principal_credential_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ServicePrincipalKey",
+					"ServicePrincipalCert",
+				}, false),
+				RequiredWith: []string{"service_principal_credential"},
+				ConflictsWith: []string{
+					"use_managed_identity",
+				},
+			},
+
+			// Used for Azure AD service-principal certificate authentication, where the
+			// certificate is stored as a secret in an Azure Key Vault rather than supplied
+			// inline via `service_principal_key`.
+			"service_principal_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"service_principal_key",
+					"use_managed_identity",
+				},
+				RequiredWith: []string{"service_principal_credential_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linked_service_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"secret_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
 			},
 
 			"tenant_id": {
@@ -119,6 +235,46 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			// Enables Always Encrypted with Azure Key Vault, so the Data Factory service
+			// can decrypt Always Encrypted columns when reading from/writing to the
+			// database.
+			"always_encrypted_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"always_encrypted_akv_auth_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ServicePrincipal",
+								"ManagedIdentity",
+							}, false),
+						},
+
+						"service_principal_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"service_principal_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"integration_runtime_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -176,15 +332,37 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate(d *schema.Reso
 
 	sqlDatabaseProperties := &datafactory.AzureSQLDatabaseLinkedServiceTypeProperties{}
 
-	if v, ok := d.GetOk("connection_string"); ok {
-		sqlDatabaseProperties.ConnectionString = &datafactory.SecureString{
-			Value: utils.String(v.(string)),
-			Type:  datafactory.TypeSecureString,
-		}
+	connectionString := d.Get("connection_string").(string)
+	if connectionString == "" {
+		connectionString = buildDataFactoryLinkedServiceConnectionString(d)
+	}
+
+	if connectionString == "" {
+		return fmt.Errorf("either `connection_string` or `server` and `database` must be specified")
+	}
+
+	sqlDatabaseProperties.ConnectionString = &datafactory.SecureString{
+		Value: utils.String(connectionString),
+		Type:  datafactory.TypeSecureString,
 	}
 
+	// `connection_string` is Computed so it can be assembled above - write the
+	// resolved value back since the API never returns a SecureString's value on Read.
+	d.Set("connection_string", connectionString)
+
+	_, hasCredential := d.GetOk("credential")
+	_, hasServicePrincipalCredential := d.GetOk("service_principal_credential")
+
 	if d.Get("use_managed_identity").(bool) {
 		sqlDatabaseProperties.Tenant = utils.String(d.Get("tenant_id").(string))
+	} else if hasCredential {
+		// a shared `azurerm_data_factory_credential` reference is set below - it's
+		// mutually exclusive with the legacy service-principal fields at the schema
+		// level, so there's nothing to populate on this branch.
+	} else if hasServicePrincipalCredential {
+		// AAD service-principal certificate auth is set below via
+		// `ServicePrincipalCredentialType`/`ServicePrincipalCredential` - it's mutually
+		// exclusive with `service_principal_key` at the schema level.
 	} else {
 		secureString := datafactory.SecureString{
 			Value: utils.String(d.Get("service_principal_key").(string)),
@@ -196,6 +374,22 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate(d *schema.Reso
 		sqlDatabaseProperties.ServicePrincipalKey = &secureString
 	}
 
+	if hasCredential {
+		sqlDatabaseProperties.Credential = expandDataFactoryLinkedServiceCredential(d.Get("credential").([]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_principal_credential_type"); ok {
+		sqlDatabaseProperties.ServicePrincipalCredentialType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_principal_credential"); ok {
+		sqlDatabaseProperties.ServicePrincipalCredential = expandAzureKeyVaultPassword(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("always_encrypted_settings"); ok {
+		sqlDatabaseProperties.AlwaysEncryptedSettings = expandDataFactoryLinkedServiceAlwaysEncryptedSettings(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("key_vault_password"); ok {
 		password := v.([]interface{})
 		sqlDatabaseProperties.Password = expandAzureKeyVaultPassword(password)
@@ -286,6 +480,13 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseRead(d *schema.ResourceData
 		} else {
 			d.Set("use_managed_identity", true)
 		}
+
+		if credential := sql.Credential; credential != nil {
+			if err := d.Set("credential", flattenDataFactoryLinkedServiceCredential(credential)); err != nil {
+				return fmt.Errorf("setting `credential`: %+v", err)
+			}
+			d.Set("use_managed_identity", false)
+		}
 	}
 
 	d.Set("additional_properties", sql.AdditionalProperties)
@@ -299,6 +500,23 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseRead(d *schema.ResourceData
 		}
 	}
 
+	if sql.ServicePrincipalCredentialType != nil {
+		d.Set("service_principal_credential_type", sql.ServicePrincipalCredentialType)
+	}
+
+	if credential := sql.ServicePrincipalCredential; credential != nil {
+		if keyVaultCredential, ok := credential.AsAzureKeyVaultSecretReference(); ok {
+			if err := d.Set("service_principal_credential", flattenAzureKeyVaultPassword(keyVaultCredential)); err != nil {
+				return fmt.Errorf("setting `service_principal_credential`: %+v", err)
+			}
+			d.Set("use_managed_identity", false)
+		}
+	}
+
+	if err := d.Set("always_encrypted_settings", flattenDataFactoryLinkedServiceAlwaysEncryptedSettings(sql.AlwaysEncryptedSettings, d)); err != nil {
+		return fmt.Errorf("setting `always_encrypted_settings`: %+v", err)
+	}
+
 	annotations := flattenDataFactoryAnnotations(sql.Annotations)
 	if err := d.Set("annotations", annotations); err != nil {
 		return fmt.Errorf("Error setting `annotations`: %+v", err)
@@ -337,3 +555,85 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseDelete(d *schema.ResourceDa
 
 	return nil
 }
+
+func expandDataFactoryLinkedServiceCredential(input []interface{}) *datafactory.CredentialReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &datafactory.CredentialReference{
+		ReferenceName: utils.String(raw["name"].(string)),
+		Type:          datafactory.TypeCredentialReference,
+	}
+}
+
+func flattenDataFactoryLinkedServiceCredential(input *datafactory.CredentialReference) []interface{} {
+	if input == nil || input.ReferenceName == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": *input.ReferenceName,
+		},
+	}
+}
+
+func expandDataFactoryLinkedServiceAlwaysEncryptedSettings(input []interface{}) *datafactory.SQLAlwaysEncryptedProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	result := &datafactory.SQLAlwaysEncryptedProperties{
+		AlwaysEncryptedAkvAuthType: datafactory.AzureKeyVaultAuthType(raw["always_encrypted_akv_auth_type"].(string)),
+	}
+
+	if v := raw["service_principal_id"].(string); v != "" {
+		result.ServicePrincipalID = utils.String(v)
+	}
+
+	if v := raw["service_principal_key"].(string); v != "" {
+		result.ServicePrincipalKey = &datafactory.SecureString{
+			Value: utils.String(v),
+			Type:  datafactory.TypeSecureString,
+		}
+	}
+
+	if v := raw["tenant_id"].(string); v != "" {
+		result.Tenant = utils.String(v)
+	}
+
+	return result
+}
+
+func flattenDataFactoryLinkedServiceAlwaysEncryptedSettings(input *datafactory.SQLAlwaysEncryptedProperties, d *schema.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	servicePrincipalID := ""
+	if input.ServicePrincipalID != nil {
+		servicePrincipalID = *input.ServicePrincipalID
+	}
+
+	tenantID := ""
+	if input.Tenant != nil {
+		tenantID = *input.Tenant
+	}
+
+	// Azure never returns the secret back, so preserve whatever's already in state
+	servicePrincipalKey := d.Get("always_encrypted_settings.0.service_principal_key").(string)
+
+	return []interface{}{
+		map[string]interface{}{
+			"always_encrypted_akv_auth_type": string(input.AlwaysEncryptedAkvAuthType),
+			"service_principal_id":           servicePrincipalID,
+			"service_principal_key":          servicePrincipalKey,
+			"tenant_id":                      tenantID,
+		},
+	}
+}
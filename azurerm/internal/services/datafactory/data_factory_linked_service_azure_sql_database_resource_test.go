@@ -0,0 +1,247 @@
+package datafactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_credential(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_azure_sql_database.test"
+	ri := acceptance.RandomInteger()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_credential(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_servicePrincipalCredential(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_azure_sql_database.test"
+	ri := acceptance.RandomInteger()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_servicePrincipalCredential(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_alwaysEncrypted(t *testing.T) {
+	resourceName := "azurerm_data_factory_linked_service_azure_sql_database.test"
+	ri := acceptance.RandomInteger()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_alwaysEncrypted(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseExists(resourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"always_encrypted_settings.0.service_principal_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.LinkedServiceClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Data Factory Linked Service AzureSQLDatabase not found: %s", resourceName)
+		}
+
+		id, err := parse.LinkedServiceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Data Factory Linked Service AzureSQLDatabase %q (Data Factory %q / Resource Group %q) does not exist", id.Name, id.FactoryName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("retrieving Data Factory Linked Service AzureSQLDatabase %q (Data Factory %q / Resource Group %q): %+v", id.Name, id.FactoryName, id.ResourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryLinkedServiceAzureSQLDatabaseDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.LinkedServiceClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_linked_service_azure_sql_database" {
+			continue
+		}
+
+		id, err := parse.LinkedServiceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Data Factory Linked Service AzureSQLDatabase still exists: %q", id.Name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_credential(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_credential" "test" {
+  name                = "acctestdfcred%d"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  service_principal {
+    tenant_id             = "00000000-0000-0000-0000-000000000000"
+    service_principal_id  = "11111111-1111-1111-1111-111111111111"
+    service_principal_key = "acctestsecret%d"
+  }
+}
+
+resource "azurerm_data_factory_linked_service_azure_sql_database" "test" {
+  name                = "acctestdfls%d"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  server              = "acctest%d.database.windows.net"
+  database            = "acctestdb%d"
+
+  credential {
+    name = azurerm_data_factory_credential.test.name
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_servicePrincipalCredential(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_azure_sql_database" "test" {
+  name                = "acctestdfls%d"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  server              = "acctest%d.database.windows.net"
+  database            = "acctestdb%d"
+  tenant_id           = "00000000-0000-0000-0000-000000000000"
+
+  service_principal_credential_type = "ServicePrincipalCert"
+
+  service_principal_credential {
+    linked_service_name = "acctestdfakv%d"
+    secret_name         = "acctestsecret%d"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryLinkedServiceAzureSQLDatabase_alwaysEncrypted(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_azure_sql_database" "test" {
+  name                 = "acctestdfls%d"
+  data_factory_name    = azurerm_data_factory.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+  server               = "acctest%d.database.windows.net"
+  database             = "acctestdb%d"
+  use_managed_identity = true
+
+  always_encrypted_settings {
+    always_encrypted_akv_auth_type = "ServicePrincipal"
+    service_principal_id           = "11111111-1111-1111-1111-111111111111"
+    service_principal_key          = "acctestsecret%d"
+    tenant_id                      = "00000000-0000-0000-0000-000000000000"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,89 @@
+package datafactory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// buildDataFactoryLinkedServiceConnectionString assembles a canonical ADO.NET
+// connection string from the structured `server`/`database`/... fields, used when
+// `connection_string` is not set directly.
+func buildDataFactoryLinkedServiceConnectionString(d *schema.ResourceData) string {
+	var parts []string
+
+	if v, ok := d.GetOk("server"); ok {
+		parts = append(parts, fmt.Sprintf("Server=%s", v.(string)))
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		parts = append(parts, fmt.Sprintf("Database=%s", v.(string)))
+	}
+
+	if v, ok := d.GetOkExists("encrypt"); ok {
+		parts = append(parts, fmt.Sprintf("Encrypt=%s", strconv.FormatBool(v.(bool))))
+	}
+
+	if v, ok := d.GetOkExists("trust_server_certificate"); ok {
+		parts = append(parts, fmt.Sprintf("TrustServerCertificate=%s", strconv.FormatBool(v.(bool))))
+	}
+
+	if v, ok := d.GetOkExists("connection_timeout"); ok {
+		parts = append(parts, fmt.Sprintf("Connection Timeout=%d", v.(int)))
+	}
+
+	if v, ok := d.GetOk("application_name"); ok {
+		parts = append(parts, fmt.Sprintf("Application Name=%s", v.(string)))
+	}
+
+	if v, ok := d.GetOk("failover_partner"); ok {
+		parts = append(parts, fmt.Sprintf("Failover Partner=%s", v.(string)))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// azureRmDataFactoryLinkedServiceConnectionStringDiff compares ADO.NET connection
+// strings semantically rather than as raw strings, since Azure re-serializes the
+// string it's given with a different key order/casing than what was submitted -
+// which otherwise produces a spurious diff on every plan.
+func azureRmDataFactoryLinkedServiceConnectionStringDiff(_, old, new string, _ *schema.ResourceData) bool {
+	oldValues := parseDataFactoryConnectionString(old)
+	newValues := parseDataFactoryConnectionString(new)
+
+	if len(oldValues) != len(newValues) {
+		return false
+	}
+
+	for key, value := range newValues {
+		oldValue, ok := oldValues[key]
+		if !ok || oldValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseDataFactoryConnectionString(connectionString string) map[string]string {
+	values := make(map[string]string)
+
+	for _, pair := range strings.Split(connectionString, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		values[key] = strings.TrimSpace(parts[1])
+	}
+
+	return values
+}
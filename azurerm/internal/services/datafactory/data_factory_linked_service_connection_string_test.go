@@ -0,0 +1,78 @@
+package datafactory
+
+import "testing"
+
+func TestAzureRMDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "identical",
+			Old:      "Server=server1;Database=db1",
+			New:      "Server=server1;Database=db1",
+			Suppress: true,
+		},
+		{
+			Name:     "different order",
+			Old:      "Server=server1;Database=db1",
+			New:      "Database=db1;Server=server1",
+			Suppress: true,
+		},
+		{
+			Name:     "different key case",
+			Old:      "server=server1;database=db1",
+			New:      "Server=server1;Database=db1",
+			Suppress: true,
+		},
+		{
+			Name:     "different value case",
+			Old:      "Server=server1;Database=db1",
+			New:      "Server=SERVER1;Database=db1",
+			Suppress: false,
+		},
+		{
+			Name:     "different value",
+			Old:      "Server=server1;Database=db1",
+			New:      "Server=server2;Database=db1",
+			Suppress: false,
+		},
+		{
+			Name:     "different key count",
+			Old:      "Server=server1;Database=db1",
+			New:      "Server=server1;Database=db1;Encrypt=true",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := azureRmDataFactoryLinkedServiceConnectionStringDiff("connection_string", tc.Old, tc.New, nil)
+			if actual != tc.Suppress {
+				t.Fatalf("expected suppress=%t but got suppress=%t", tc.Suppress, actual)
+			}
+		})
+	}
+}
+
+func TestParseDataFactoryConnectionString(t *testing.T) {
+	actual := parseDataFactoryConnectionString("Server=server1;Database=db1;Encrypt=true")
+
+	expected := map[string]string{
+		"server":   "server1",
+		"database": "db1",
+		"encrypt":  "true",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d keys but got %d", len(expected), len(actual))
+	}
+
+	for key, value := range expected {
+		if actual[key] != value {
+			t.Fatalf("expected %q=%q but got %q=%q", key, value, key, actual[key])
+		}
+	}
+}
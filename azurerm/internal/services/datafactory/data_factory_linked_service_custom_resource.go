@@ -0,0 +1,437 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceDataFactoryLinkedServiceCustom is a permanent escape hatch for linked
+// service types the provider hasn't wrapped with a dedicated resource yet (e.g.
+// Snowflake, Salesforce, REST, OData) - `type` and `type_properties_json` are passed
+// through to the API as-is, relying on the SDK's polymorphic dispatch to decode them.
+func resourceDataFactoryLinkedServiceCustom() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataFactoryLinkedServiceCustomCreateUpdate,
+		Read:   resourceDataFactoryLinkedServiceCustomRead,
+		Update: resourceDataFactoryLinkedServiceCustomCreateUpdate,
+		Delete: resourceDataFactoryLinkedServiceCustomDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"type_properties_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.JSONDiffSuppressFunc,
+			},
+
+			// Names (dot-separated paths into `type_properties_json`, e.g.
+			// `password` or `connectionProperties.password`) of properties that
+			// should be re-wrapped as a `SecureString` on write and suppressed on
+			// read-back, since the API never returns secret values.
+			"sensitive_properties": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"integration_runtime_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryLinkedServiceCustomCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_linked_service_custom", *existing.ID)
+		}
+	}
+
+	typeProperties, err := expandDataFactoryLinkedServiceCustomTypeProperties(d)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"type":           d.Get("type").(string),
+		"typeProperties": typeProperties,
+		"description":    d.Get("description").(string),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		payload["parameters"] = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("integration_runtime_name"); ok {
+		payload["connectVia"] = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
+	}
+
+	reservedPayloadKeys := map[string]bool{
+		"type": true, "typeProperties": true, "description": true,
+		"parameters": true, "connectVia": true, "annotations": true,
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			if reservedPayloadKeys[key] {
+				return fmt.Errorf("`additional_properties` cannot contain the reserved key %q", key)
+			}
+
+			payload[key] = value
+		}
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		payload["annotations"] = v.([]interface{})
+	}
+
+	// datafactory.BasicLinkedService is a bare interface - encoding/json has no
+	// concrete type to decode into and can't dispatch on it directly. The generated
+	// polymorphic dispatch instead lives on datafactory.LinkedServiceResource's own
+	// UnmarshalJSON, so decode into that concrete struct (wrapping payload under
+	// `properties`, matching the API's own envelope) and pull .Properties back out.
+	raw, err := json.Marshal(map[string]interface{}{
+		"properties": payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling Data Factory Linked Service Custom %q properties: %+v", name, err)
+	}
+
+	var linkedService datafactory.LinkedServiceResource
+	if err := json.Unmarshal(raw, &linkedService); err != nil {
+		return fmt.Errorf("unmarshalling Data Factory Linked Service Custom %q properties: %+v", name, err)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, linkedService, ""); err != nil {
+		return fmt.Errorf("creating/updating Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("cannot read Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceDataFactoryLinkedServiceCustomRead(d, meta)
+}
+
+func resourceDataFactoryLinkedServiceCustomRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LinkedServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", id.Name, id.FactoryName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", id.FactoryName)
+
+	if resp.Properties == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("marshalling Data Factory Linked Service Custom %q properties: %+v", id.Name, err)
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return fmt.Errorf("unmarshalling Data Factory Linked Service Custom %q properties: %+v", id.Name, err)
+	}
+
+	d.Set("type", generic["type"])
+	d.Set("description", generic["description"])
+
+	typePropertiesJSON, err := flattenDataFactoryLinkedServiceCustomTypeProperties(generic["typeProperties"], d.Get("sensitive_properties").(*schema.Set), d.Get("type_properties_json").(string))
+	if err != nil {
+		return err
+	}
+	d.Set("type_properties_json", typePropertiesJSON)
+
+	if connectVia, ok := generic["connectVia"].(map[string]interface{}); ok {
+		if referenceName, ok := connectVia["referenceName"].(string); ok {
+			d.Set("integration_runtime_name", referenceName)
+		}
+	}
+
+	if parameters, ok := generic["parameters"]; ok {
+		if err := d.Set("parameters", parameters); err != nil {
+			return fmt.Errorf("setting `parameters`: %+v", err)
+		}
+	}
+
+	if annotations, ok := generic["annotations"]; ok {
+		if err := d.Set("annotations", annotations); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryLinkedServiceCustomDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LinkedServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Data Factory Linked Service Custom %q (Data Factory %q / Resource Group %q): %+v", id.Name, id.FactoryName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDataFactoryLinkedServiceCustomTypeProperties decodes `type_properties_json`
+// and re-wraps every dot-separated path named in `sensitive_properties` (e.g.
+// `password` or `connectionProperties.password`) as a `SecureString`, so the raw
+// secret value isn't sent to the API as a plain string.
+func expandDataFactoryLinkedServiceCustomTypeProperties(d *schema.ResourceData) (map[string]interface{}, error) {
+	var typeProperties map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("type_properties_json").(string)), &typeProperties); err != nil {
+		return nil, fmt.Errorf("parsing `type_properties_json`: %+v", err)
+	}
+
+	sensitive := d.Get("sensitive_properties").(*schema.Set)
+	for _, raw := range sensitive.List() {
+		path := raw.(string)
+
+		value, ok := dataFactoryCustomTypePropertiesGet(typeProperties, path)
+		if !ok {
+			continue
+		}
+
+		stringValue, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		dataFactoryCustomTypePropertiesSet(typeProperties, path, map[string]interface{}{
+			"type":  "SecureString",
+			"value": stringValue,
+		})
+	}
+
+	return typeProperties, nil
+}
+
+// flattenDataFactoryLinkedServiceCustomTypeProperties re-serialises the API's
+// `typeProperties` back into the plain JSON shape the user wrote. Since Azure never
+// returns a secret, any `SecureString` for a property named in `sensitive_properties`
+// is replaced by the value already in state (`existingJSON`); where state has none
+// (e.g. on import) the `SecureString`'s returned `value` is used instead.
+func flattenDataFactoryLinkedServiceCustomTypeProperties(input interface{}, sensitive *schema.Set, existingJSON string) (string, error) {
+	typeProperties, ok := input.(map[string]interface{})
+	if !ok {
+		raw, err := json.Marshal(input)
+		if err != nil {
+			return "", fmt.Errorf("marshalling `type_properties_json`: %+v", err)
+		}
+		return string(raw), nil
+	}
+
+	var existing map[string]interface{}
+	if existingJSON != "" {
+		if err := json.Unmarshal([]byte(existingJSON), &existing); err != nil {
+			existing = nil
+		}
+	}
+
+	for _, raw := range sensitive.List() {
+		path := raw.(string)
+
+		value, ok := dataFactoryCustomTypePropertiesGet(typeProperties, path)
+		if !ok {
+			continue
+		}
+
+		secureValue, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if existingValue, ok := dataFactoryCustomTypePropertiesGet(existing, path); ok {
+			dataFactoryCustomTypePropertiesSet(typeProperties, path, existingValue)
+			continue
+		}
+
+		if stringValue, ok := secureValue["value"].(string); ok {
+			dataFactoryCustomTypePropertiesSet(typeProperties, path, stringValue)
+		}
+	}
+
+	raw, err := json.Marshal(typeProperties)
+	if err != nil {
+		return "", fmt.Errorf("marshalling `type_properties_json`: %+v", err)
+	}
+
+	return string(raw), nil
+}
+
+// dataFactoryCustomTypePropertiesGet reads the value at a dot-separated path (e.g.
+// `connectionProperties.password`) out of a decoded `type_properties_json` document.
+func dataFactoryCustomTypePropertiesGet(typeProperties map[string]interface{}, path string) (interface{}, bool) {
+	segments := strings.Split(path, ".")
+
+	current := interface{}(typeProperties)
+	for _, segment := range segments {
+		asMap, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok := asMap[segment]
+		if !ok {
+			return nil, false
+		}
+
+		current = value
+	}
+
+	return current, true
+}
+
+// dataFactoryCustomTypePropertiesSet writes a value at a dot-separated path (e.g.
+// `connectionProperties.password`) into a decoded `type_properties_json` document.
+// The path must already exist - it only ever replaces a value expand/flatten has
+// already confirmed is there via dataFactoryCustomTypePropertiesGet.
+func dataFactoryCustomTypePropertiesSet(typeProperties map[string]interface{}, path string, value interface{}) {
+	segments := strings.Split(path, ".")
+
+	current := typeProperties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+}
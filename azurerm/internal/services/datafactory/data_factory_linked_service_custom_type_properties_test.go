@@ -0,0 +1,88 @@
+package datafactory
+
+import "testing"
+
+func TestDataFactoryCustomTypePropertiesGet(t *testing.T) {
+	typeProperties := map[string]interface{}{
+		"password": "top-level-secret",
+		"connectionProperties": map[string]interface{}{
+			"password": "nested-secret",
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Path     string
+		Expected interface{}
+		Found    bool
+	}{
+		{Name: "top level", Path: "password", Expected: "top-level-secret", Found: true},
+		{Name: "nested", Path: "connectionProperties.password", Expected: "nested-secret", Found: true},
+		{Name: "missing top level", Path: "missing", Found: false},
+		{Name: "missing nested", Path: "connectionProperties.missing", Found: false},
+		{Name: "path through a non-map", Path: "password.nested", Found: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, ok := dataFactoryCustomTypePropertiesGet(typeProperties, tc.Path)
+			if ok != tc.Found {
+				t.Fatalf("expected found=%t but got found=%t", tc.Found, ok)
+			}
+
+			if tc.Found && actual != tc.Expected {
+				t.Fatalf("expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestDataFactoryCustomTypePropertiesSet(t *testing.T) {
+	typeProperties := map[string]interface{}{
+		"password": "top-level-secret",
+		"connectionProperties": map[string]interface{}{
+			"password": "nested-secret",
+		},
+	}
+
+	dataFactoryCustomTypePropertiesSet(typeProperties, "password", "replaced-top-level")
+	dataFactoryCustomTypePropertiesSet(typeProperties, "connectionProperties.password", "replaced-nested")
+
+	if typeProperties["password"] != "replaced-top-level" {
+		t.Fatalf("expected top-level password to be replaced, got %q", typeProperties["password"])
+	}
+
+	connectionProperties := typeProperties["connectionProperties"].(map[string]interface{})
+	if connectionProperties["password"] != "replaced-nested" {
+		t.Fatalf("expected nested password to be replaced, got %q", connectionProperties["password"])
+	}
+}
+
+func TestDataFactoryCustomTypePropertiesWrapNestedSensitive(t *testing.T) {
+	input := map[string]interface{}{
+		"connectionProperties": map[string]interface{}{
+			"password": "super-secret",
+		},
+	}
+
+	path := "connectionProperties.password"
+	value, ok := dataFactoryCustomTypePropertiesGet(input, path)
+	if !ok {
+		t.Fatalf("expected to find %q", path)
+	}
+
+	dataFactoryCustomTypePropertiesSet(input, path, map[string]interface{}{
+		"type":  "SecureString",
+		"value": value,
+	})
+
+	connectionProperties := input["connectionProperties"].(map[string]interface{})
+	wrapped, ok := connectionProperties["password"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected nested password to have been wrapped as a SecureString, got %#v", connectionProperties["password"])
+	}
+
+	if wrapped["value"] != "super-secret" {
+		t.Fatalf("expected wrapped value %q but got %q", "super-secret", wrapped["value"])
+	}
+}
@@ -0,0 +1,38 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type CredentialId struct {
+	ResourceGroup string
+	FactoryName   string
+	Name          string
+}
+
+func CredentialID(input string) (*CredentialId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Data Factory Credential ID %q: %+v", input, err)
+	}
+
+	credential := CredentialId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if credential.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, err
+	}
+
+	if credential.Name, err = id.PopSegment("credentials"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
@@ -0,0 +1,66 @@
+package parse
+
+import "testing"
+
+func TestCredentialID(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Error    bool
+		Expected *CredentialId
+	}{
+		{
+			Name:  "empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "no resource groups segment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Name:  "no credentials segment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/factory1",
+			Error: true,
+		},
+		{
+			Name:  "credential id",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/factory1/credentials/credential1",
+			Error: false,
+			Expected: &CredentialId{
+				ResourceGroup: "group1",
+				FactoryName:   "factory1",
+				Name:          "credential1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := CredentialID(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if actual.ResourceGroup != tc.Expected.ResourceGroup {
+				t.Fatalf("expected ResourceGroup %q but got %q", tc.Expected.ResourceGroup, actual.ResourceGroup)
+			}
+
+			if actual.FactoryName != tc.Expected.FactoryName {
+				t.Fatalf("expected FactoryName %q but got %q", tc.Expected.FactoryName, actual.FactoryName)
+			}
+
+			if actual.Name != tc.Expected.Name {
+				t.Fatalf("expected Name %q but got %q", tc.Expected.Name, actual.Name)
+			}
+		})
+	}
+}
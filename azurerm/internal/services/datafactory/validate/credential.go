@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// CredentialName validates that a string is a valid azurerm_data_factory_credential
+// name, matching the characters the Data Factory API accepts for a Credential
+// object - used both by that resource's own `name` and by every `credential`
+// block elsewhere that references one by name.
+func CredentialName() func(interface{}, string) ([]string, []error) {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if !regexp.MustCompile(`^[-A-Za-z0-9_]{1,260}$`).MatchString(v) {
+			errors = append(errors, fmt.Errorf("%q must be between 1 and 260 characters and can only contain letters, numbers, underscores and hyphens: %q", k, v))
+		}
+
+		return
+	}
+}
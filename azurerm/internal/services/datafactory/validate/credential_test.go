@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestCredentialName(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+		Valid bool
+	}{
+		{
+			Name:  "empty",
+			Input: "",
+			Valid: false,
+		},
+		{
+			Name:  "valid",
+			Input: "credential1",
+			Valid: true,
+		},
+		{
+			Name:  "valid with hyphen and underscore",
+			Input: "my-credential_1",
+			Valid: true,
+		},
+		{
+			Name:  "invalid character",
+			Input: "my credential",
+			Valid: false,
+		},
+		{
+			Name:  "too long",
+			Input: stringOfLength(261),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := CredentialName()(tc.Input, "name")
+
+			valid := len(errors) == 0
+			if valid != tc.Valid {
+				t.Fatalf("expected valid=%t but got valid=%t (errors: %+v)", tc.Valid, valid, errors)
+			}
+		})
+	}
+}
+
+func stringOfLength(n int) string {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = 'a'
+	}
+	return string(out)
+}